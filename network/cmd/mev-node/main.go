@@ -7,6 +7,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/mev-protocol/network/internal/corebridge"
 	"github.com/mev-protocol/network/internal/mempool"
 	"github.com/mev-protocol/network/internal/relay"
 	"github.com/mev-protocol/network/internal/rpc"
@@ -34,20 +35,37 @@ func main() {
 
 	// Initialize components
 	rpcPool := rpc.NewPool(cfg.RPC)
-	mempoolMonitor := mempool.NewMonitor(cfg.Mempool, rpcPool)
-	flashbotsRelay := relay.NewFlashbots(cfg.Relay)
+	mempoolMonitor := mempool.NewMonitor(cfg.Mempool, mempool.NewGethWSSource(rpcPool))
+	multiRelay := relay.NewMultiRelay(
+		relay.NewFlashbots(cfg.Relay),
+		relay.NewBloXroute(cfg.Relay),
+	)
+	// The core bridge is optional: with no MEV_CORE_ADDR configured, the
+	// monitor falls back to its log-only forwardToCore path, which is the
+	// supported standalone mode.
+	var coreBridge *corebridge.Bridge
+	if cfg.Core.Addr != "" {
+		coreBridge = corebridge.NewBridge(cfg.Core, multiRelay)
+		mempoolMonitor.SetCoreBridge(coreBridge)
+	}
 
 	// Start components
 	if err := rpcPool.Start(ctx); err != nil {
 		log.Fatal().Err(err).Msg("Failed to start RPC pool")
 	}
 
-	if err := mempoolMonitor.Start(ctx); err != nil {
-		log.Fatal().Err(err).Msg("Failed to start mempool monitor")
+	if err := multiRelay.Start(ctx); err != nil {
+		log.Fatal().Err(err).Msg("Failed to start relays")
+	}
+
+	if coreBridge != nil {
+		if err := coreBridge.Start(ctx); err != nil {
+			log.Fatal().Err(err).Msg("Failed to start core bridge")
+		}
 	}
 
-	if err := flashbotsRelay.Start(ctx); err != nil {
-		log.Fatal().Err(err).Msg("Failed to start relay")
+	if err := mempoolMonitor.Start(ctx); err != nil {
+		log.Fatal().Err(err).Msg("Failed to start mempool monitor")
 	}
 
 	log.Info().Msg("All components started successfully")
@@ -64,7 +82,10 @@ func main() {
 	defer shutdownCancel()
 
 	mempoolMonitor.Stop(shutdownCtx)
-	flashbotsRelay.Stop(shutdownCtx)
+	if coreBridge != nil {
+		coreBridge.Stop()
+	}
+	multiRelay.Stop(shutdownCtx)
 	rpcPool.Stop(shutdownCtx)
 
 	log.Info().Msg("Shutdown complete")
@@ -74,6 +95,7 @@ type Config struct {
 	RPC     rpc.Config
 	Mempool mempool.Config
 	Relay   relay.Config
+	Core    corebridge.Config
 }
 
 func loadConfig() (*Config, error) {
@@ -88,6 +110,12 @@ func loadConfig() (*Config, error) {
 			RequestTimeout:      5 * time.Second,
 			ReconnectDelay:      time.Second,
 			HealthCheckInterval: 30 * time.Second,
+			HealthCheckTimeout:  5 * time.Second,
+			PerMethodTimeouts: map[string]time.Duration{
+				"eth_getBlockByNumber": 10 * time.Second,
+				"debug_traceCall":      30 * time.Second,
+				"eth_getBalance":       10 * time.Second,
+			},
 		},
 		Mempool: mempool.Config{
 			BufferSize:      10000,
@@ -98,9 +126,23 @@ func loadConfig() (*Config, error) {
 		Relay: relay.Config{
 			FlashbotsURL:  "https://relay.flashbots.net",
 			BloXrouteURL:  "https://mev.api.blxrbdn.com",
+			EdenURL:       "https://api.edennetwork.io/v1/bundle",
+			ManifoldURL:   "https://eth.manifoldfinance.com",
 			SigningKey:    os.Getenv("FLASHBOTS_SIGNING_KEY"),
+			BloXrouteAuth: os.Getenv("BLOXROUTE_AUTH_TOKEN"),
+			EdenAuth:      os.Getenv("EDEN_AUTH_TOKEN"),
+			ManifoldAuth:  os.Getenv("MANIFOLD_AUTH_TOKEN"),
 			MaxRetries:    3,
 			SubmitTimeout: 2 * time.Second,
 		},
+		Core: corebridge.Config{
+			Addr:               os.Getenv("MEV_CORE_ADDR"),
+			ClientCertFile:     os.Getenv("MEV_CORE_CLIENT_CERT"),
+			ClientKeyFile:      os.Getenv("MEV_CORE_CLIENT_KEY"),
+			CACertFile:         os.Getenv("MEV_CORE_CA_CERT"),
+			QueueSize:          10000,
+			HealthCheckPeriod:  10 * time.Second,
+			HealthCheckTimeout: 2 * time.Second,
+		},
 	}, nil
 }
@@ -0,0 +1,60 @@
+package corebridge
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/mev-protocol/network/internal/mempool"
+	"github.com/mev-protocol/network/internal/relay"
+	mevpb "github.com/mev-protocol/network/proto/mevpb"
+)
+
+// toProtoPendingTx converts a mempool.PendingTx into its wire form.
+func toProtoPendingTx(tx *mempool.PendingTx) *mevpb.PendingTx {
+	pb := &mevpb.PendingTx{
+		Hash:           tx.Hash.Bytes(),
+		From:           tx.From.Bytes(),
+		Value:          tx.Value,
+		GasPrice:       tx.GasPrice,
+		GasLimit:       tx.GasLimit,
+		Input:          tx.Input,
+		Nonce:          tx.Nonce,
+		SeenUnixMillis: tx.Timestamp.UnixMilli(),
+		Source:         tx.Source,
+	}
+	if tx.To != nil {
+		pb.To = tx.To.Bytes()
+	}
+	return pb
+}
+
+// fromProtoBundle converts a core-supplied Bundle (raw signed txs, ready to
+// submit) into the relay package's Bundle shape.
+func fromProtoBundle(pb *mevpb.Bundle) *relay.Bundle {
+	bundle := &relay.Bundle{
+		BlockNumber: blockNumberHex(pb.TargetBlock),
+	}
+
+	for _, tx := range pb.Txs {
+		bundle.Txs = append(bundle.Txs, hexBytes(tx))
+	}
+	for _, hash := range pb.RevertingTxHashes {
+		bundle.RevertingTxHashes = append(bundle.RevertingTxHashes, hexBytes(hash))
+	}
+
+	if pb.MinTimestamp != nil {
+		bundle.MinTimestamp = pb.MinTimestamp
+	}
+	if pb.MaxTimestamp != nil {
+		bundle.MaxTimestamp = pb.MaxTimestamp
+	}
+
+	return bundle
+}
+
+func blockNumberHex(n uint64) string {
+	return hexutil.EncodeUint64(n)
+}
+
+func hexBytes(b []byte) string {
+	return hexutil.Encode(b)
+}
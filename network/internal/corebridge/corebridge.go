@@ -0,0 +1,300 @@
+// Package corebridge streams pending transactions from the Go node to the
+// Rust core over gRPC and routes the opportunities the core reports back
+// into relay submission. It replaces the old forwardToCore log-only stub in
+// internal/mempool.
+package corebridge
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/mev-protocol/network/internal/mempool"
+	"github.com/mev-protocol/network/internal/relay"
+	mevpb "github.com/mev-protocol/network/proto/mevpb"
+)
+
+// Config for the core bridge.
+type Config struct {
+	Addr               string
+	ClientCertFile     string
+	ClientKeyFile      string
+	CACertFile         string
+	QueueSize          int
+	HealthCheckPeriod  time.Duration
+	HealthCheckTimeout time.Duration
+}
+
+// Bridge maintains a single long-lived streaming gRPC connection to the
+// Rust core: pending transactions flow out over StreamPendingTx, and
+// OpportunityAck messages flow back, getting routed into relay submission.
+type Bridge struct {
+	config     Config
+	multiRelay *relay.MultiRelay
+
+	conn   *grpc.ClientConn
+	client mevpb.MevCoreClient
+
+	queue chan *mempool.PendingTx
+
+	mu      sync.Mutex
+	dropped uint64
+	healthy bool
+
+	wg sync.WaitGroup
+}
+
+// NewBridge creates a core bridge that forwards pending transactions to the
+// Rust core at cfg.Addr and routes opportunities it reports back to
+// multiRelay.
+func NewBridge(cfg Config, multiRelay *relay.MultiRelay) *Bridge {
+	if cfg.QueueSize == 0 {
+		cfg.QueueSize = 10000
+	}
+	if cfg.HealthCheckPeriod == 0 {
+		cfg.HealthCheckPeriod = 10 * time.Second
+	}
+	if cfg.HealthCheckTimeout == 0 {
+		cfg.HealthCheckTimeout = 2 * time.Second
+	}
+
+	return &Bridge{
+		config:     cfg,
+		multiRelay: multiRelay,
+		queue:      make(chan *mempool.PendingTx, cfg.QueueSize),
+	}
+}
+
+// Start dials the core over mutual TLS and begins the long-lived
+// StreamPendingTx call plus an independent health-check loop.
+func (b *Bridge) Start(ctx context.Context) error {
+	creds, err := b.loadTLSCredentials()
+	if err != nil {
+		return fmt.Errorf("loading mTLS credentials: %w", err)
+	}
+
+	conn, err := grpc.DialContext(ctx, b.config.Addr,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithBlock(),
+	)
+	if err != nil {
+		return fmt.Errorf("dialing core: %w", err)
+	}
+
+	b.conn = conn
+	b.client = mevpb.NewMevCoreClient(conn)
+
+	b.wg.Add(2)
+	go b.streamLoop(ctx)
+	go b.healthCheckLoop(ctx)
+
+	log.Info().Str("addr", b.config.Addr).Msg("Core bridge started")
+	return nil
+}
+
+// Stop closes the connection to the core and waits for its goroutines to exit.
+func (b *Bridge) Stop() {
+	if b.conn != nil {
+		b.conn.Close()
+	}
+	b.wg.Wait()
+}
+
+// Push implements mempool.CoreBridge. It enqueues tx to be forwarded to the
+// core. If the queue is full (the core is lagging), the oldest queued tx is
+// dropped to make room so the node keeps serving the freshest data, and
+// Dropped is incremented.
+func (b *Bridge) Push(tx *mempool.PendingTx) bool {
+	select {
+	case b.queue <- tx:
+		return true
+	default:
+	}
+
+	select {
+	case <-b.queue:
+		b.incDropped()
+	default:
+	}
+
+	select {
+	case b.queue <- tx:
+		return true
+	default:
+		b.incDropped()
+		return false
+	}
+}
+
+func (b *Bridge) incDropped() {
+	b.mu.Lock()
+	b.dropped++
+	b.mu.Unlock()
+}
+
+// Dropped returns the number of pending transactions dropped because the
+// core was lagging behind the queue.
+func (b *Bridge) Dropped() uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.dropped
+}
+
+// Healthy reports the result of the most recent HealthCheck call.
+func (b *Bridge) Healthy() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.healthy
+}
+
+func (b *Bridge) streamLoop(ctx context.Context) {
+	defer b.wg.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		if err := b.runStream(ctx); err != nil && ctx.Err() == nil {
+			log.Error().Err(err).Msg("Core stream error, reconnecting...")
+			time.Sleep(time.Second)
+		}
+	}
+}
+
+func (b *Bridge) runStream(ctx context.Context) error {
+	stream, err := b.client.StreamPendingTx(ctx)
+	if err != nil {
+		return fmt.Errorf("opening PendingTx stream: %w", err)
+	}
+
+	acks := make(chan *mevpb.OpportunityAck)
+	recvErr := make(chan error, 1)
+
+	go func() {
+		for {
+			ack, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				close(acks)
+				return
+			}
+			acks <- ack
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			_ = stream.CloseSend()
+			return ctx.Err()
+
+		case err := <-recvErr:
+			return err
+
+		case ack, ok := <-acks:
+			if !ok {
+				continue
+			}
+			// Relay submission blocks on every relay's HTTP round trip, so
+			// it must not run inline here: that would stall both sending
+			// further pending txs and receiving further acks for as long
+			// as submission takes.
+			b.wg.Add(1)
+			go func(ack *mevpb.OpportunityAck) {
+				defer b.wg.Done()
+				b.handleOpportunityAck(ctx, ack)
+			}(ack)
+
+		case tx := <-b.queue:
+			if err := stream.Send(toProtoPendingTx(tx)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Submit hands bundle to the core directly over the unary Submit RPC,
+// bypassing the pending-tx stream. Intended for callers that assemble a
+// bundle outside the normal mempool-watching path (an operator tool, a
+// manual replay) and want a synchronous bundle hash back rather than
+// waiting on an OpportunityAck.
+func (b *Bridge) Submit(ctx context.Context, bundle *mevpb.Bundle) (*mevpb.SubmitResponse, error) {
+	return b.client.Submit(ctx, bundle)
+}
+
+func (b *Bridge) handleOpportunityAck(ctx context.Context, ack *mevpb.OpportunityAck) {
+	if ack.Bundle == nil {
+		return
+	}
+
+	bundle := fromProtoBundle(ack.Bundle)
+	result := b.multiRelay.SendBundle(ctx, bundle)
+
+	log.Info().
+		Strs("acceptedBy", result.Accepted()).
+		Str("opportunityType", ack.OpportunityType).
+		Int64("expectedProfitWei", ack.ExpectedProfitWei).
+		Msg("Submitted opportunity bundle from core")
+}
+
+func (b *Bridge) healthCheckLoop(ctx context.Context) {
+	defer b.wg.Done()
+
+	ticker := time.NewTicker(b.config.HealthCheckPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			checkCtx, cancel := context.WithTimeout(ctx, b.config.HealthCheckTimeout)
+			resp, err := b.client.HealthCheck(checkCtx, &mevpb.HealthCheckRequest{})
+			cancel()
+
+			healthy := err == nil && resp.GetHealthy()
+			if !healthy {
+				log.Warn().Err(err).Msg("Core health check failed")
+			}
+
+			b.mu.Lock()
+			b.healthy = healthy
+			b.mu.Unlock()
+		}
+	}
+}
+
+func (b *Bridge) loadTLSCredentials() (credentials.TransportCredentials, error) {
+	clientCert, err := tls.LoadX509KeyPair(b.config.ClientCertFile, b.config.ClientKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading client cert: %w", err)
+	}
+
+	caCert, err := os.ReadFile(b.config.CACertFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading CA cert: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("appending CA cert to pool")
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{clientCert},
+		RootCAs:      pool,
+	}), nil
+}
@@ -0,0 +1,42 @@
+package megabundle
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mev-protocol/network/pkg/types"
+)
+
+// slotKey identifies a single storage slot touched by a simulation.
+type slotKey struct {
+	addr common.Address
+	slot common.Hash
+}
+
+// slotConflictDetector is the default ConflictDetector: two bundles
+// conflict if their touched-slot sets overlap. SimulationResult.StateChanges
+// only reports slots a bundle wrote, so only write/write overlaps are
+// detected; a bundle that reads, without writing, a slot the other bundle
+// writes is not flagged as conflicting.
+type slotConflictDetector struct{}
+
+// NewSlotConflictDetector returns the default ConflictDetector, which flags
+// two bundles as conflicting when their StateChanges touch the same
+// (address, slot) pair.
+func NewSlotConflictDetector() ConflictDetector {
+	return slotConflictDetector{}
+}
+
+// Conflicts implements ConflictDetector.
+func (slotConflictDetector) Conflicts(a, b *types.SimulationResult) bool {
+	touched := make(map[slotKey]bool, len(a.StateChanges))
+	for _, sc := range a.StateChanges {
+		touched[slotKey{sc.Address, sc.Slot}] = true
+	}
+
+	for _, sc := range b.StateChanges {
+		if touched[slotKey{sc.Address, sc.Slot}] {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,59 @@
+package megabundle
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mev-protocol/network/pkg/types"
+)
+
+func TestSlotConflictDetectorConflicts(t *testing.T) {
+	addrA := common.HexToAddress("0x1")
+	addrB := common.HexToAddress("0x2")
+	slot1 := common.HexToHash("0x1")
+	slot2 := common.HexToHash("0x2")
+
+	tests := []struct {
+		name string
+		a, b *types.SimulationResult
+		want bool
+	}{
+		{
+			name: "disjoint slots don't conflict",
+			a:    &types.SimulationResult{StateChanges: []types.StateChange{{Address: addrA, Slot: slot1}}},
+			b:    &types.SimulationResult{StateChanges: []types.StateChange{{Address: addrA, Slot: slot2}}},
+			want: false,
+		},
+		{
+			name: "same address, same slot conflicts",
+			a:    &types.SimulationResult{StateChanges: []types.StateChange{{Address: addrA, Slot: slot1}}},
+			b:    &types.SimulationResult{StateChanges: []types.StateChange{{Address: addrA, Slot: slot1}}},
+			want: true,
+		},
+		{
+			name: "same slot, different address doesn't conflict",
+			a:    &types.SimulationResult{StateChanges: []types.StateChange{{Address: addrA, Slot: slot1}}},
+			b:    &types.SimulationResult{StateChanges: []types.StateChange{{Address: addrB, Slot: slot1}}},
+			want: false,
+		},
+		{
+			name: "no state changes never conflicts",
+			a:    &types.SimulationResult{},
+			b:    &types.SimulationResult{StateChanges: []types.StateChange{{Address: addrA, Slot: slot1}}},
+			want: false,
+		},
+	}
+
+	d := NewSlotConflictDetector()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := d.Conflicts(tt.a, tt.b); got != tt.want {
+				t.Errorf("Conflicts() = %v, want %v", got, tt.want)
+			}
+			// Conflicts must be symmetric regardless of argument order.
+			if got := d.Conflicts(tt.b, tt.a); got != tt.want {
+				t.Errorf("Conflicts() reversed = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
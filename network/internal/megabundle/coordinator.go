@@ -0,0 +1,132 @@
+package megabundle
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/mev-protocol/network/pkg/types"
+	"github.com/rs/zerolog/log"
+)
+
+// candidate pairs a queued bundle with its simulation result, once known.
+type candidate struct {
+	bundle *types.Bundle
+	sim    *types.SimulationResult
+}
+
+// Coordinator collects candidate bundles from many searchers and, on
+// demand, greedily merges the non-conflicting, most-profitable ones into a
+// single ordered megabundle.
+type Coordinator struct {
+	config     Config
+	sim        Simulator
+	conflicts  ConflictDetector
+	mu         sync.Mutex
+	candidates []candidate
+}
+
+// NewCoordinator creates a megabundle coordinator. sim simulates both
+// individual candidates and the final merged result; conflicts decides
+// whether two simulated bundles may be merged. A nil conflicts uses
+// NewSlotConflictDetector.
+func NewCoordinator(cfg Config, sim Simulator, conflicts ConflictDetector) *Coordinator {
+	if conflicts == nil {
+		conflicts = NewSlotConflictDetector()
+	}
+
+	return &Coordinator{
+		config:    cfg,
+		sim:       sim,
+		conflicts: conflicts,
+	}
+}
+
+// AddBundle queues a candidate bundle for the next BuildMegabundle call.
+func (c *Coordinator) AddBundle(b *types.Bundle) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.candidates = append(c.candidates, candidate{bundle: b})
+}
+
+// BuildMegabundle simulates every queued candidate, greedily merges the
+// non-conflicting ones in order of effective coinbase payment per gas, and
+// re-simulates the merged result against the current pending state before
+// returning it. Queued candidates are cleared once consumed.
+func (c *Coordinator) BuildMegabundle(ctx context.Context, target uint64) (*types.Bundle, *types.SimulationResult, error) {
+	c.mu.Lock()
+	pending := c.candidates
+	c.candidates = nil
+	c.mu.Unlock()
+
+	for i := range pending {
+		if pending[i].sim != nil {
+			continue
+		}
+
+		sim, err := c.sim.Simulate(ctx, pending[i].bundle)
+		if err != nil {
+			return nil, nil, fmt.Errorf("simulating candidate bundle: %w", err)
+		}
+		pending[i].sim = sim
+	}
+
+	sort.Slice(pending, func(i, j int) bool {
+		return profitPerGas(pending[i].sim) > profitPerGas(pending[j].sim)
+	})
+
+	var merged []types.BundleTx
+	var accepted []*types.SimulationResult
+	var gasUsed uint64
+
+	for _, cand := range pending {
+		if !cand.sim.Success {
+			continue
+		}
+		if gasUsed+cand.sim.GasUsed > c.config.GasBudget {
+			continue
+		}
+		if conflictsWithAny(c.conflicts, cand.sim, accepted) {
+			continue
+		}
+
+		merged = append(merged, cand.bundle.Transactions...)
+		accepted = append(accepted, cand.sim)
+		gasUsed += cand.sim.GasUsed
+	}
+
+	megabundle := &types.Bundle{
+		Transactions: merged,
+		TargetBlock:  target,
+	}
+
+	result, err := c.sim.Simulate(ctx, megabundle)
+	if err != nil {
+		return nil, nil, fmt.Errorf("simulating megabundle: %w", err)
+	}
+
+	log.Info().
+		Int("candidates", len(pending)).
+		Int("merged", len(accepted)).
+		Uint64("gasUsed", gasUsed).
+		Msg("Built megabundle")
+
+	return megabundle, result, nil
+}
+
+func conflictsWithAny(d ConflictDetector, sim *types.SimulationResult, accepted []*types.SimulationResult) bool {
+	for _, a := range accepted {
+		if d.Conflicts(sim, a) {
+			return true
+		}
+	}
+	return false
+}
+
+func profitPerGas(sim *types.SimulationResult) float64 {
+	if sim == nil || sim.GasUsed == 0 {
+		return 0
+	}
+	return float64(sim.Profit) / float64(sim.GasUsed)
+}
@@ -0,0 +1,31 @@
+// Package megabundle merges several candidate bundles into a single
+// block-top "megabundle", the way mev-geth's eth_sendMegabundle extension
+// lets a builder combine multiple searchers' bundles into one block without
+// them conflicting with each other.
+package megabundle
+
+import (
+	"context"
+
+	"github.com/mev-protocol/network/pkg/types"
+)
+
+// Simulator re-simulates a bundle (a single candidate or an already-merged
+// megabundle) against the current pending state. internal/sim provides an
+// in-process implementation; a relay-backed one is also possible.
+type Simulator interface {
+	Simulate(ctx context.Context, bundle *types.Bundle) (*types.SimulationResult, error)
+}
+
+// ConflictDetector decides whether two already-simulated bundles touch
+// overlapping state and therefore cannot be merged into the same
+// megabundle.
+type ConflictDetector interface {
+	Conflicts(a, b *types.SimulationResult) bool
+}
+
+// Config controls how a Coordinator builds megabundles.
+type Config struct {
+	// GasBudget is the cumulative GasUsed a megabundle may not exceed.
+	GasBudget uint64
+}
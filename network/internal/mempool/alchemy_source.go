@@ -0,0 +1,100 @@
+package mempool
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mev-protocol/network/internal/rpc"
+)
+
+// AlchemyMinedTxSource subscribes to Alchemy's alchemy_minedTransactions
+// feed, which reports transactions as they are mined rather than as they
+// enter the mempool. Combined with a mempool-facing Source, Monitor's
+// per-source FirstSeen and Duplicates counts show how often the mempool feed
+// saw a tx before this one reported it mined.
+type AlchemyMinedTxSource struct {
+	pool *rpc.Pool
+}
+
+// NewAlchemyMinedTxSource creates a Source backed by pool's WebSocket client.
+func NewAlchemyMinedTxSource(pool *rpc.Pool) *AlchemyMinedTxSource {
+	return &AlchemyMinedTxSource{pool: pool}
+}
+
+// Name implements Source.
+func (s *AlchemyMinedTxSource) Name() string { return "alchemy-mined" }
+
+// alchemyMinedTxNotification is the payload of a single
+// alchemy_minedTransactions push.
+type alchemyMinedTxNotification struct {
+	Transaction struct {
+		Hash     string `json:"hash"`
+		From     string `json:"from"`
+		To       string `json:"to"`
+		Value    string `json:"value"`
+		GasPrice string `json:"gasPrice"`
+		Gas      string `json:"gas"`
+		Input    string `json:"input"`
+		Nonce    string `json:"nonce"`
+	} `json:"transaction"`
+}
+
+// Subscribe implements Source.
+func (s *AlchemyMinedTxSource) Subscribe(ctx context.Context, out chan<- *PendingTx) error {
+	client, err := s.pool.GetWSClient()
+	if err != nil {
+		return err
+	}
+
+	notifications := make(chan json.RawMessage, 1000)
+	sub, err := client.Client.Client().EthSubscribe(ctx, notifications, "alchemy_minedTransactions")
+	if err != nil {
+		return fmt.Errorf("subscribing to alchemy_minedTransactions: %w", err)
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case err := <-sub.Err():
+			return err
+
+		case raw := <-notifications:
+			var notification alchemyMinedTxNotification
+			if err := json.Unmarshal(raw, &notification); err != nil {
+				continue
+			}
+
+			tx := notification.Transaction
+			if tx.Hash == "" {
+				continue
+			}
+
+			pendingTx := &PendingTx{
+				Hash:      common.HexToHash(tx.Hash),
+				From:      common.HexToAddress(tx.From),
+				Value:     decodeHexUint64(tx.Value),
+				GasPrice:  decodeHexUint64(tx.GasPrice),
+				GasLimit:  decodeHexUint64(tx.Gas),
+				Nonce:     decodeHexUint64(tx.Nonce),
+				Input:     decodeHexBytes(tx.Input),
+				Timestamp: time.Now(),
+			}
+			if tx.To != "" {
+				to := common.HexToAddress(tx.To)
+				pendingTx.To = &to
+			}
+
+			select {
+			case out <- pendingTx:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
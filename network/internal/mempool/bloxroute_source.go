@@ -0,0 +1,128 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/websocket"
+)
+
+// BloxrouteSource subscribes to BloXroute's newTxs WSS feed, which
+// broadcasts pending transactions to Cloud-API subscribers ahead of public
+// mempool gossip.
+type BloxrouteSource struct {
+	url       string
+	authToken string
+}
+
+// NewBloxrouteSource creates a Source backed by BloXroute's streaming
+// gateway at url, authenticated with authToken.
+func NewBloxrouteSource(url, authToken string) *BloxrouteSource {
+	return &BloxrouteSource{url: url, authToken: authToken}
+}
+
+// Name implements Source.
+func (s *BloxrouteSource) Name() string { return "bloxroute" }
+
+// bloxrouteTxNotification mirrors a single newTxs push from BloXroute's
+// streaming gateway.
+type bloxrouteTxNotification struct {
+	Params struct {
+		Result struct {
+			TxHash     string `json:"txHash"`
+			TxContents struct {
+				To       string `json:"to"`
+				Value    string `json:"value"`
+				GasPrice string `json:"gasPrice"`
+				Gas      string `json:"gas"`
+				Input    string `json:"input"`
+				Nonce    string `json:"nonce"`
+				From     string `json:"from"`
+			} `json:"txContents"`
+		} `json:"result"`
+	} `json:"params"`
+}
+
+// Subscribe implements Source.
+func (s *BloxrouteSource) Subscribe(ctx context.Context, out chan<- *PendingTx) error {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, s.url, http.Header{
+		"Authorization": []string{s.authToken},
+	})
+	if err != nil {
+		return fmt.Errorf("dialing bloxroute: %w", err)
+	}
+	defer conn.Close()
+
+	subscribe := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "subscribe",
+		"params":  []interface{}{"newTxs", map[string]interface{}{"include": []string{"tx_hash", "tx_contents"}}},
+	}
+	if err := conn.WriteJSON(subscribe); err != nil {
+		return fmt.Errorf("subscribing to newTxs: %w", err)
+	}
+
+	for {
+		var notification bloxrouteTxNotification
+		if err := conn.ReadJSON(&notification); err != nil {
+			return fmt.Errorf("reading bloxroute feed: %w", err)
+		}
+
+		result := notification.Params.Result
+		if result.TxHash == "" {
+			continue
+		}
+
+		pendingTx := &PendingTx{
+			Hash:      common.HexToHash(result.TxHash),
+			From:      common.HexToAddress(result.TxContents.From),
+			Value:     decodeHexUint64(result.TxContents.Value),
+			GasPrice:  decodeHexUint64(result.TxContents.GasPrice),
+			GasLimit:  decodeHexUint64(result.TxContents.Gas),
+			Nonce:     decodeHexUint64(result.TxContents.Nonce),
+			Input:     decodeHexBytes(result.TxContents.Input),
+			Timestamp: time.Now(),
+		}
+		if result.TxContents.To != "" {
+			to := common.HexToAddress(result.TxContents.To)
+			pendingTx.To = &to
+		}
+
+		select {
+		case out <- pendingTx:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// decodeHexUint64 decodes a 0x-prefixed hex quantity, returning 0 for an
+// empty or malformed value rather than failing the whole notification.
+func decodeHexUint64(s string) uint64 {
+	if s == "" {
+		return 0
+	}
+	v, err := hexutil.DecodeUint64(s)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+// decodeHexBytes decodes 0x-prefixed hex data, returning nil for an empty or
+// malformed value rather than failing the whole notification.
+func decodeHexBytes(s string) []byte {
+	if s == "" {
+		return nil
+	}
+	b, err := hexutil.Decode(s)
+	if err != nil {
+		return nil
+	}
+	return b
+}
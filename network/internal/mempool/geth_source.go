@@ -0,0 +1,72 @@
+package mempool
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/mev-protocol/network/internal/rpc"
+)
+
+// GethWSSource subscribes to a geth-compatible node's newPendingTransactions
+// WebSocket feed.
+type GethWSSource struct {
+	pool *rpc.Pool
+}
+
+// NewGethWSSource creates a Source backed by pool's WebSocket client.
+func NewGethWSSource(pool *rpc.Pool) *GethWSSource {
+	return &GethWSSource{pool: pool}
+}
+
+// Name implements Source.
+func (s *GethWSSource) Name() string { return "geth-ws" }
+
+// Subscribe implements Source.
+func (s *GethWSSource) Subscribe(ctx context.Context, out chan<- *PendingTx) error {
+	client, err := s.pool.GetWSClient()
+	if err != nil {
+		return err
+	}
+
+	txChan := make(chan *types.Transaction, 1000)
+	sub, err := gethclient.New(client.Client.Client()).SubscribeFullPendingTransactions(ctx, txChan)
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case err := <-sub.Err():
+			return err
+
+		case tx := <-txChan:
+			pendingTx := &PendingTx{
+				Hash:      tx.Hash(),
+				To:        tx.To(),
+				Value:     tx.Value().Uint64(),
+				GasPrice:  tx.GasPrice().Uint64(),
+				GasLimit:  tx.Gas(),
+				Input:     tx.Data(),
+				Nonce:     tx.Nonce(),
+				Timestamp: time.Now(),
+			}
+
+			signer := types.LatestSignerForChainID(tx.ChainId())
+			if from, err := types.Sender(signer, tx); err == nil {
+				pendingTx.From = from
+			}
+
+			select {
+			case out <- pendingTx:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
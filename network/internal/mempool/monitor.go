@@ -6,8 +6,6 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/mev-protocol/network/internal/rpc"
 	"github.com/rs/zerolog/log"
 )
 
@@ -17,6 +15,9 @@ type Config struct {
 	FilterEnabled   bool
 	MinValue        float64
 	TargetSelectors []string
+	// DedupWindow is how long a tx hash is remembered for cross-source
+	// deduplication. Defaults to 2 minutes if unset.
+	DedupWindow time.Duration
 }
 
 // PendingTx represents a pending transaction
@@ -30,47 +31,88 @@ type PendingTx struct {
 	Input     []byte
 	Nonce     uint64
 	Timestamp time.Time
+	Source    string
 }
 
-// Monitor watches the mempool for pending transactions
+// CoreBridge forwards pending transactions to the Rust core. Push returns
+// false if the transaction was dropped (e.g. the core is lagging).
+// internal/corebridge provides the gRPC-backed implementation.
+type CoreBridge interface {
+	Push(tx *PendingTx) bool
+}
+
+// SourceStats are the per-source metrics Monitor tracks: how many of a
+// source's txs passed dedup and the filters, how many of those were dropped because the fan-in buffer was
+// full, how many were duplicates of a tx another source already delivered,
+// and how often this source saw a tx before any other (first-seen
+// advantage).
+type SourceStats struct {
+	Received   uint64
+	Dropped    uint64
+	Duplicates uint64
+	FirstSeen  uint64
+}
+
+// Monitor fans in pending transactions from any number of Sources,
+// deduplicating by tx hash and applying the configured value/selector
+// filters before handing transactions to consumers via TxChan.
 type Monitor struct {
 	config    Config
-	rpcPool   *rpc.Pool
+	sources   []Source
 	txChan    chan *PendingTx
 	selectors map[string]bool
-	mu        sync.RWMutex
-	running   bool
-	wg        sync.WaitGroup
+
+	mu      sync.RWMutex
+	running bool
+	wg      sync.WaitGroup
+	seen    map[common.Hash]time.Time
+	statsMu sync.Mutex
+	stats   map[string]*SourceStats
+
+	bridge CoreBridge
+}
+
+// SetCoreBridge configures where processed transactions are forwarded. If
+// unset, forwardToCore only logs, which is useful for standalone testing.
+func (m *Monitor) SetCoreBridge(bridge CoreBridge) {
+	m.bridge = bridge
 }
 
-// NewMonitor creates a new mempool monitor
-func NewMonitor(cfg Config, pool *rpc.Pool) *Monitor {
+// NewMonitor creates a new mempool monitor fanning in over sources.
+func NewMonitor(cfg Config, sources ...Source) *Monitor {
 	selectors := make(map[string]bool)
 	for _, sel := range cfg.TargetSelectors {
 		selectors[sel] = true
 	}
 
+	stats := make(map[string]*SourceStats, len(sources))
+	for _, s := range sources {
+		stats[s.Name()] = &SourceStats{}
+	}
+
 	return &Monitor{
 		config:    cfg,
-		rpcPool:   pool,
+		sources:   sources,
 		txChan:    make(chan *PendingTx, cfg.BufferSize),
 		selectors: selectors,
+		seen:      make(map[common.Hash]time.Time),
+		stats:     stats,
 	}
 }
 
-// Start begins monitoring the mempool
+// Start begins monitoring every configured source.
 func (m *Monitor) Start(ctx context.Context) error {
 	m.mu.Lock()
 	m.running = true
 	m.mu.Unlock()
 
-	log.Info().Msg("Starting mempool monitor")
+	log.Info().Int("sources", len(m.sources)).Msg("Starting mempool monitor")
 
-	// Start subscription workers
-	m.wg.Add(1)
-	go m.subscribeLoop(ctx)
+	for _, src := range m.sources {
+		m.wg.Add(1)
+		go m.subscribeLoop(ctx, src)
+	}
 
-	// Start processor
 	m.wg.Add(1)
 	go m.processLoop(ctx)
 
@@ -87,14 +129,29 @@ func (m *Monitor) Stop(ctx context.Context) {
 	m.wg.Wait()
 }
 
-// TxChan returns the channel for pending transactions
+// TxChan returns the channel for deduplicated, filtered pending transactions.
 func (m *Monitor) TxChan() <-chan *PendingTx {
 	return m.txChan
 }
 
-func (m *Monitor) subscribeLoop(ctx context.Context) {
+// Stats returns a snapshot of per-source metrics.
+func (m *Monitor) Stats() map[string]SourceStats {
+	m.statsMu.Lock()
+	defer m.statsMu.Unlock()
+
+	snapshot := make(map[string]SourceStats, len(m.stats))
+	for name, s := range m.stats {
+		snapshot[name] = *s
+	}
+	return snapshot
+}
+
+func (m *Monitor) subscribeLoop(ctx context.Context, src Source) {
 	defer m.wg.Done()
 
+	srcChan := make(chan *PendingTx, m.config.BufferSize)
+	go m.fanIn(src.Name(), srcChan)
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -109,86 +166,111 @@ func (m *Monitor) subscribeLoop(ctx context.Context) {
 		}
 		m.mu.RUnlock()
 
-		// Subscribe to pending transactions
-		if err := m.subscribe(ctx); err != nil {
-			log.Error().Err(err).Msg("Subscription error, reconnecting...")
+		if err := src.Subscribe(ctx, srcChan); err == nil {
+			// The feed ended on its own (e.g. a replay file was exhausted),
+			// so there is nothing to resubscribe to.
+			return
+		} else {
+			log.Error().Err(err).Str("source", src.Name()).Msg("Subscription error, reconnecting...")
 			time.Sleep(time.Second)
 		}
 	}
 }
 
-func (m *Monitor) subscribe(ctx context.Context) error {
-	// Get WebSocket client
-	client, err := m.rpcPool.GetWSClient()
-	if err != nil {
-		return err
+// fanIn reads a single source's channel, applies dedup and filtering, and
+// forwards surviving transactions into the shared txChan.
+func (m *Monitor) fanIn(source string, srcChan <-chan *PendingTx) {
+	for tx := range srcChan {
+		tx.Source = source
+		m.handleTransaction(tx)
 	}
+}
 
-	// Subscribe to pending transactions
-	txChan := make(chan *types.Transaction, 1000)
-	sub, err := client.SubscribeNewPendingTransactions(ctx, txChan)
-	if err != nil {
-		return err
-	}
-	defer sub.Unsubscribe()
-
-	log.Info().Msg("Subscribed to pending transactions")
-
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-
-		case err := <-sub.Err():
-			return err
+func (m *Monitor) handleTransaction(tx *PendingTx) {
+	stats := m.statFor(tx.Source)
 
-		case tx := <-txChan:
-			m.handleTransaction(tx)
-		}
+	if m.dedup(tx.Hash) {
+		stats.Duplicates++
+		return
 	}
-}
+	stats.FirstSeen++
 
-func (m *Monitor) handleTransaction(tx *types.Transaction) {
 	// Apply filters
 	if m.config.FilterEnabled {
 		// Check minimum value
-		if tx.Value().Uint64() < uint64(m.config.MinValue) && len(tx.Data()) < 4 {
+		if tx.Value < uint64(m.config.MinValue) && len(tx.Input) < 4 {
 			return
 		}
 
 		// Check selector
-		if len(tx.Data()) >= 4 {
-			selector := "0x" + common.Bytes2Hex(tx.Data()[:4])
+		if len(tx.Input) >= 4 {
+			selector := "0x" + common.Bytes2Hex(tx.Input[:4])
 			if !m.selectors[selector] {
 				return
 			}
 		}
 	}
 
-	// Convert to our format
-	pendingTx := &PendingTx{
-		Hash:      tx.Hash(),
-		To:        tx.To(),
-		Value:     tx.Value().Uint64(),
-		GasPrice:  tx.GasPrice().Uint64(),
-		GasLimit:  tx.Gas(),
-		Input:     tx.Data(),
-		Nonce:     tx.Nonce(),
-		Timestamp: time.Now(),
-	}
-
-	// Get sender address
-	signer := types.LatestSignerForChainID(tx.ChainId())
-	if from, err := types.Sender(signer, tx); err == nil {
-		pendingTx.From = from
-	}
+	stats.Received++
 
 	// Send to channel (non-blocking)
 	select {
-	case m.txChan <- pendingTx:
+	case m.txChan <- tx:
 	default:
-		log.Warn().Msg("Tx channel full, dropping transaction")
+		stats.Dropped++
+		log.Warn().Str("source", tx.Source).Msg("Tx channel full, dropping transaction")
+	}
+}
+
+// dedupWindow returns the configured dedup window, defaulting to 2 minutes.
+func (m *Monitor) dedupWindow() time.Duration {
+	if m.config.DedupWindow == 0 {
+		return 2 * time.Minute
+	}
+	return m.config.DedupWindow
+}
+
+// dedup reports whether hash has already been seen within the configured
+// dedup window, recording it as seen either way.
+func (m *Monitor) dedup(hash common.Hash) bool {
+	window := m.dedupWindow()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if firstSeen, ok := m.seen[hash]; ok && time.Since(firstSeen) < window {
+		return true
+	}
+
+	m.seen[hash] = time.Now()
+	return false
+}
+
+// evictSeen drops entries from seen older than the dedup window so the map
+// doesn't grow without bound for the life of the process.
+func (m *Monitor) evictSeen() {
+	cutoff := time.Now().Add(-m.dedupWindow())
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for hash, firstSeen := range m.seen {
+		if firstSeen.Before(cutoff) {
+			delete(m.seen, hash)
+		}
+	}
+}
+
+func (m *Monitor) statFor(source string) *SourceStats {
+	m.statsMu.Lock()
+	defer m.statsMu.Unlock()
+
+	s, ok := m.stats[source]
+	if !ok {
+		s = &SourceStats{}
+		m.stats[source] = s
 	}
+	return s
 }
 
 func (m *Monitor) processLoop(ctx context.Context) {
@@ -197,6 +279,9 @@ func (m *Monitor) processLoop(ctx context.Context) {
 	ticker := time.NewTicker(time.Second)
 	defer ticker.Stop()
 
+	evictTicker := time.NewTicker(m.dedupWindow())
+	defer evictTicker.Stop()
+
 	var count uint64
 
 	for {
@@ -210,21 +295,29 @@ func (m *Monitor) processLoop(ctx context.Context) {
 				count = 0
 			}
 
+		case <-evictTicker.C:
+			m.evictSeen()
+
 		case tx := <-m.txChan:
 			count++
-			// Process transaction - send to Rust core via FFI or channel
 			m.forwardToCore(tx)
 		}
 	}
 }
 
 func (m *Monitor) forwardToCore(tx *PendingTx) {
-	// TODO: Send to Rust core via FFI or gRPC
-	// For now, just log
+	if m.bridge != nil {
+		if !m.bridge.Push(tx) {
+			log.Warn().Str("hash", tx.Hash.Hex()).Msg("Core bridge lagging, dropped transaction")
+		}
+		return
+	}
+
 	log.Debug().
 		Str("hash", tx.Hash.Hex()).
 		Str("to", tx.To.Hex()).
 		Uint64("value", tx.Value).
 		Int("data_len", len(tx.Input)).
+		Str("source", tx.Source).
 		Msg("Forwarding tx to core")
 }
@@ -0,0 +1,64 @@
+package mempool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestMonitorDedupWindowDefault(t *testing.T) {
+	m := NewMonitor(Config{})
+	if got := m.dedupWindow(); got != 2*time.Minute {
+		t.Errorf("dedupWindow() = %v, want %v", got, 2*time.Minute)
+	}
+}
+
+func TestMonitorDedupWindowConfigured(t *testing.T) {
+	m := NewMonitor(Config{DedupWindow: 5 * time.Second})
+	if got := m.dedupWindow(); got != 5*time.Second {
+		t.Errorf("dedupWindow() = %v, want %v", got, 5*time.Second)
+	}
+}
+
+func TestMonitorDedup(t *testing.T) {
+	m := NewMonitor(Config{DedupWindow: 20 * time.Millisecond})
+	hash := common.HexToHash("0x1")
+
+	if m.dedup(hash) {
+		t.Fatal("dedup() reported a duplicate on first sighting")
+	}
+	if !m.dedup(hash) {
+		t.Fatal("dedup() did not report a duplicate within the window")
+	}
+
+	time.Sleep(30 * time.Millisecond)
+
+	if m.dedup(hash) {
+		t.Fatal("dedup() reported a duplicate after the window elapsed")
+	}
+}
+
+func TestMonitorEvictSeen(t *testing.T) {
+	m := NewMonitor(Config{DedupWindow: 20 * time.Millisecond})
+	stale := common.HexToHash("0x1")
+	fresh := common.HexToHash("0x2")
+
+	m.dedup(stale)
+	time.Sleep(30 * time.Millisecond)
+	m.dedup(fresh)
+
+	m.evictSeen()
+
+	m.mu.RLock()
+	_, staleStillSeen := m.seen[stale]
+	_, freshStillSeen := m.seen[fresh]
+	m.mu.RUnlock()
+
+	if staleStillSeen {
+		t.Error("evictSeen() left an entry older than the dedup window")
+	}
+	if !freshStillSeen {
+		t.Error("evictSeen() dropped an entry still within the dedup window")
+	}
+}
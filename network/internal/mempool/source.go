@@ -0,0 +1,18 @@
+package mempool
+
+import "context"
+
+// Source is a feed of pending transactions. Monitor fans in over any number
+// of Sources, so strategies can combine several private/public feeds (or
+// replay a recorded one for deterministic backtesting) without the consumer
+// side changing.
+type Source interface {
+	// Name identifies the source in per-source metrics and logs.
+	Name() string
+	// Subscribe blocks, streaming pending transactions into out, until ctx
+	// is done, the underlying feed errors, or a finite feed (such as a
+	// replay file) is exhausted. Monitor calls it again (after a backoff) if
+	// it returns a non-nil error before ctx is done; a nil return ends that
+	// source's subscription.
+	Subscribe(ctx context.Context, out chan<- *PendingTx) error
+}
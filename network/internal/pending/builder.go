@@ -0,0 +1,286 @@
+package pending
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/mev-protocol/network/internal/mempool"
+	"github.com/mev-protocol/network/internal/rpc"
+	"github.com/mev-protocol/network/pkg/types"
+)
+
+// cacheKey identifies a PendingBlock result. Two Get calls with the same
+// key are guaranteed to see the same mempool watermark and extra bundle, so
+// the cached result is still valid.
+type cacheKey struct {
+	parent    common.Hash
+	watermark int
+	bundle    common.Hash
+}
+
+// Builder lazily assembles a PendingBlock from a mempool.Monitor's tx
+// stream plus a caller-supplied bundle, re-simulating only when asked and
+// caching the result for Config.TTL.
+type Builder struct {
+	config  Config
+	monitor *mempool.Monitor
+	rpcPool *rpc.Pool
+	sim     Simulator
+
+	mu        sync.Mutex
+	key       cacheKey
+	cached    *PendingBlock
+	expiresAt time.Time
+
+	txMu       sync.RWMutex
+	mempoolTxs []common.Hash
+	// mempoolSeen counts every tx received, so the cache watermark keeps
+	// advancing after mempoolTxs is capped at Config.MaxTxs.
+	mempoolSeen int
+	maxGasSeen  uint64
+	lastHead    common.Hash
+
+	subsMu sync.Mutex
+	subs   []chan struct{}
+
+	wg sync.WaitGroup
+}
+
+// NewBuilder creates a pending-block builder that watches monitor for new
+// mempool transactions and uses sim to re-simulate when the cache is stale.
+func NewBuilder(cfg Config, monitor *mempool.Monitor, pool *rpc.Pool, sim Simulator) *Builder {
+	return &Builder{
+		config:  cfg,
+		monitor: monitor,
+		rpcPool: pool,
+		sim:     sim,
+	}
+}
+
+// Start begins watching the mempool for transactions that would materially
+// change the pending block.
+func (b *Builder) Start(ctx context.Context) {
+	b.wg.Add(2)
+	go b.watchMempool(ctx)
+	go b.watchNewHeads(ctx)
+}
+
+// Stop waits for the mempool and new-head watchers to exit.
+func (b *Builder) Stop() {
+	b.wg.Wait()
+}
+
+// Subscribe returns a channel that receives a value whenever the pending
+// block materially changes: a new high-fee mempool tx arrives, or the
+// parent block is replaced. The channel has a one-slot buffer and sends are
+// non-blocking, so a slow consumer can miss a notification; it should
+// just call Get again whenever convenient.
+func (b *Builder) Subscribe() <-chan struct{} {
+	ch := make(chan struct{}, 1)
+
+	b.subsMu.Lock()
+	b.subs = append(b.subs, ch)
+	b.subsMu.Unlock()
+
+	return ch
+}
+
+func (b *Builder) notify() {
+	b.subsMu.Lock()
+	defer b.subsMu.Unlock()
+
+	for _, ch := range b.subs {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (b *Builder) watchMempool(ctx context.Context) {
+	defer b.wg.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case tx, ok := <-b.monitor.TxChan():
+			if !ok {
+				return
+			}
+
+			b.txMu.Lock()
+			b.mempoolTxs = append(b.mempoolTxs, tx.Hash)
+			b.mempoolSeen++
+			if b.config.MaxTxs > 0 && len(b.mempoolTxs) > b.config.MaxTxs {
+				b.mempoolTxs = b.mempoolTxs[len(b.mempoolTxs)-b.config.MaxTxs:]
+			}
+			materialChange := tx.GasPrice > b.maxGasSeen
+			if materialChange {
+				b.maxGasSeen = tx.GasPrice
+			}
+			b.txMu.Unlock()
+
+			if materialChange {
+				b.notify()
+			}
+		}
+	}
+}
+
+// watchNewHeads subscribes to new chain heads over pool's WebSocket client
+// and notifies subscribers whenever the parent block changes, resetting
+// maxGasSeen so materialChange is evaluated against the new pending block
+// rather than the highest fee ever observed since process start.
+func (b *Builder) watchNewHeads(ctx context.Context) {
+	defer b.wg.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		if err := b.subscribeNewHeads(ctx); err != nil && ctx.Err() == nil {
+			log.Error().Err(err).Msg("New-head subscription error, reconnecting...")
+			time.Sleep(time.Second)
+		}
+	}
+}
+
+func (b *Builder) subscribeNewHeads(ctx context.Context) error {
+	client, err := b.rpcPool.GetWSClient()
+	if err != nil {
+		return err
+	}
+
+	headChan := make(chan *gethtypes.Header, 16)
+	sub, err := client.SubscribeNewHead(ctx, headChan)
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case err := <-sub.Err():
+			return err
+
+		case header := <-headChan:
+			b.onNewHead(header.Hash())
+		}
+	}
+}
+
+func (b *Builder) onNewHead(head common.Hash) {
+	b.txMu.Lock()
+	changed := b.lastHead != (common.Hash{}) && head != b.lastHead
+	b.lastHead = head
+	if changed {
+		b.maxGasSeen = 0
+	}
+	b.txMu.Unlock()
+
+	if changed {
+		b.notify()
+	}
+}
+
+// Get returns the current PendingBlock. Its Txs are the hashes of the
+// mempool transactions received so far, in arrival order and capped at
+// Config.MaxTxs; the mempool transactions are not simulated. If extraBundle
+// is non-nil it is simulated on its own against current state and supplies
+// StateRootDelta and CoinbasePayment; its transactions are not added to Txs.
+// A cached result is reused if the parent block, mempool watermark, and
+// extraBundle are unchanged since the last call within Config.TTL.
+func (b *Builder) Get(ctx context.Context, extraBundle *types.Bundle) (*PendingBlock, error) {
+	client, err := b.rpcPool.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("getting rpc client: %w", err)
+	}
+
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetching head header: %w", err)
+	}
+
+	b.txMu.RLock()
+	mempoolTxs := append([]common.Hash{}, b.mempoolTxs...)
+	watermark := b.mempoolSeen
+	b.txMu.RUnlock()
+
+	key := cacheKey{
+		parent:    header.Hash(),
+		watermark: watermark,
+		bundle:    bundleDigest(extraBundle),
+	}
+
+	b.mu.Lock()
+	if b.cached != nil && b.key == key && time.Now().Before(b.expiresAt) {
+		cached := b.cached
+		b.mu.Unlock()
+		return cached, nil
+	}
+	b.mu.Unlock()
+
+	block := &PendingBlock{
+		ParentHash: header.Hash(),
+		Txs:        mempoolTxs,
+	}
+
+	if extraBundle != nil {
+		result, err := b.sim.Simulate(ctx, extraBundle)
+		if err != nil {
+			return nil, fmt.Errorf("simulating extra bundle: %w", err)
+		}
+		block.StateRootDelta = result.StateChanges
+		block.CoinbasePayment = result.Profit
+	}
+
+	ttl := b.config.TTL
+	if ttl == 0 {
+		ttl = 200 * time.Millisecond
+	}
+
+	b.mu.Lock()
+	b.key = key
+	b.cached = block
+	b.expiresAt = time.Now().Add(ttl)
+	b.mu.Unlock()
+
+	log.Debug().
+		Str("parent", header.Hash().Hex()).
+		Int("mempoolTxs", len(mempoolTxs)).
+		Bool("hasExtraBundle", extraBundle != nil).
+		Msg("Rebuilt pending block")
+
+	return block, nil
+}
+
+// bundleDigest returns a stable identifier for bundle, used only to key the
+// PendingBlock cache; it is not a consensus-meaningful hash.
+func bundleDigest(bundle *types.Bundle) common.Hash {
+	if bundle == nil {
+		return common.Hash{}
+	}
+
+	data, err := json.Marshal(bundle)
+	if err != nil {
+		return common.Hash{}
+	}
+
+	return common.Hash(sha256.Sum256(data))
+}
@@ -0,0 +1,42 @@
+// Package pending lazily assembles a view of what the next block would
+// look like given the current mempool plus an optional caller-supplied
+// bundle, so strategies (arb searcher, sandwich detector, ...) can share one
+// re-simulation instead of each maintaining their own local state.
+package pending
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mev-protocol/network/pkg/types"
+)
+
+// Simulator re-simulates a bundle against current chain state. internal/sim
+// provides an implementation; megabundle.Simulator shares the same shape.
+type Simulator interface {
+	Simulate(ctx context.Context, bundle *types.Bundle) (*types.SimulationResult, error)
+}
+
+// PendingBlock is a snapshot of what the next block would look like: the
+// hashes of the mempool transactions in arrival order, and, when an extra
+// bundle was supplied, the state changes and coinbase payment from
+// simulating that bundle alone. Txs does not include the extra bundle's
+// transactions, and the mempool transactions are not reflected in
+// StateRootDelta or CoinbasePayment.
+type PendingBlock struct {
+	ParentHash      common.Hash
+	Txs             []common.Hash
+	StateRootDelta  []types.StateChange
+	CoinbasePayment int64
+}
+
+// Config controls how a Builder assembles and caches PendingBlocks.
+type Config struct {
+	// TTL bounds how long a cached PendingBlock is reused before Get
+	// re-simulates. Defaults to 200ms if unset.
+	TTL time.Duration
+	// MaxTxs caps how many mempool transactions are included when
+	// assembling a hypothetical block.
+	MaxTxs int
+}
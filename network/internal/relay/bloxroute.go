@@ -0,0 +1,94 @@
+package relay
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/rs/zerolog/log"
+)
+
+// tokenAuth authenticates requests with a static token placed directly in
+// the Authorization header, as used by BloXroute, Eden, and Manifold.
+type tokenAuth struct {
+	token string
+}
+
+// Authenticate implements AuthStrategy.
+func (a tokenAuth) Authenticate(req *http.Request, body []byte) error {
+	if a.token == "" {
+		return fmt.Errorf("auth token not configured")
+	}
+	req.Header.Set("Authorization", a.token)
+	return nil
+}
+
+// BloXroute relay client
+type BloXroute struct {
+	config     Config
+	httpClient *http.Client
+	auth       AuthStrategy
+	running    bool
+}
+
+// NewBloXroute creates a new BloXroute relay client
+func NewBloXroute(cfg Config) *BloXroute {
+	return &BloXroute{
+		config: cfg,
+		httpClient: &http.Client{
+			Timeout: cfg.SubmitTimeout,
+		},
+		auth: tokenAuth{token: cfg.BloXrouteAuth},
+	}
+}
+
+// Name implements Relay.
+func (b *BloXroute) Name() string { return "bloxroute" }
+
+// Start initializes the relay
+func (b *BloXroute) Start(ctx context.Context) error {
+	log.Info().Msg("Starting BloXroute relay")
+	b.running = true
+	return nil
+}
+
+// Stop shuts down the relay
+func (b *BloXroute) Stop(ctx context.Context) {
+	log.Info().Msg("Stopping BloXroute relay")
+	b.running = false
+}
+
+// SendBundle submits a bundle to BloXroute
+func (b *BloXroute) SendBundle(ctx context.Context, bundle *Bundle) (*BundleResponse, error) {
+	body, err := doJSONRPC(ctx, b.httpClient, b.auth, b.config.BloXrouteURL, "blxr_submit_bundle", []interface{}{bundle}, b.config.MaxRetries)
+	if err != nil {
+		return nil, err
+	}
+
+	var result BundleResponse
+	if err := decodeJSONRPCResult("bloxroute error", body, &result); err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("bundleHash", result.BundleHash).
+		Int("txCount", len(bundle.Txs)).
+		Msg("Bundle submitted to BloXroute")
+
+	return &result, nil
+}
+
+// SimulateBundle simulates a bundle via BloXroute
+func (b *BloXroute) SimulateBundle(ctx context.Context, bundle *Bundle) (*SimulationResult, error) {
+	body, err := doJSONRPC(ctx, b.httpClient, b.auth, b.config.BloXrouteURL, "blxr_simulate_bundle", []interface{}{bundle}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SimulationResult
+	if err := decodeJSONRPCResult("simulation error", body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
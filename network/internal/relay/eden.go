@@ -0,0 +1,78 @@
+package relay
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Eden relay client
+type Eden struct {
+	config     Config
+	httpClient *http.Client
+	auth       AuthStrategy
+	running    bool
+}
+
+// NewEden creates a new Eden Network relay client
+func NewEden(cfg Config) *Eden {
+	return &Eden{
+		config: cfg,
+		httpClient: &http.Client{
+			Timeout: cfg.SubmitTimeout,
+		},
+		auth: tokenAuth{token: cfg.EdenAuth},
+	}
+}
+
+// Name implements Relay.
+func (e *Eden) Name() string { return "eden" }
+
+// Start initializes the relay
+func (e *Eden) Start(ctx context.Context) error {
+	log.Info().Msg("Starting Eden relay")
+	e.running = true
+	return nil
+}
+
+// Stop shuts down the relay
+func (e *Eden) Stop(ctx context.Context) {
+	log.Info().Msg("Stopping Eden relay")
+	e.running = false
+}
+
+// SendBundle submits a bundle to Eden
+func (e *Eden) SendBundle(ctx context.Context, bundle *Bundle) (*BundleResponse, error) {
+	body, err := doJSONRPC(ctx, e.httpClient, e.auth, e.config.EdenURL, "eth_sendBundle", []interface{}{bundle}, e.config.MaxRetries)
+	if err != nil {
+		return nil, err
+	}
+
+	var result BundleResponse
+	if err := decodeJSONRPCResult("eden error", body, &result); err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("bundleHash", result.BundleHash).
+		Int("txCount", len(bundle.Txs)).
+		Msg("Bundle submitted to Eden")
+
+	return &result, nil
+}
+
+// SimulateBundle simulates a bundle via Eden
+func (e *Eden) SimulateBundle(ctx context.Context, bundle *Bundle) (*SimulationResult, error) {
+	body, err := doJSONRPC(ctx, e.httpClient, e.auth, e.config.EdenURL, "eth_callBundle", []interface{}{bundle}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SimulationResult
+	if err := decodeJSONRPCResult("simulation error", body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
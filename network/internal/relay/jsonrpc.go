@@ -0,0 +1,89 @@
+package relay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// doJSONRPC POSTs a JSON-RPC 2.0 request for method/params to url with auth
+// applied, retrying up to maxRetries times on transport error or non-200
+// status, and returns the raw response body. Every relay in this package
+// shares this same request/response shape; only the method name, URL, and
+// auth scheme differ.
+func doJSONRPC(ctx context.Context, httpClient *http.Client, auth AuthStrategy, url, method string, params []interface{}, maxRetries int) ([]byte, error) {
+	request := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  method,
+		"params":  params,
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if err := auth.Authenticate(req, body); err != nil {
+		return nil, err
+	}
+
+	var resp *http.Response
+	for i := 0; i <= maxRetries; i++ {
+		if resp != nil {
+			resp.Body.Close()
+		}
+		if i > 0 {
+			// The previous attempt consumed the request body.
+			req.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		resp, err = httpClient.Do(req)
+		if err == nil && resp.StatusCode == http.StatusOK {
+			break
+		}
+		if i < maxRetries {
+			time.Sleep(100 * time.Millisecond)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// decodeJSONRPCResult unwraps body's JSON-RPC "result"/"error" envelope
+// into out, prefixing an RPC-level error with errPrefix.
+func decodeJSONRPCResult(errPrefix string, body []byte, out interface{}) error {
+	var result struct {
+		Result json.RawMessage `json:"result"`
+		Error  *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return err
+	}
+
+	if result.Error != nil {
+		return fmt.Errorf("%s: %s", errPrefix, result.Error.Message)
+	}
+
+	return json.Unmarshal(result.Result, out)
+}
@@ -0,0 +1,78 @@
+package relay
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Manifold relay client
+type Manifold struct {
+	config     Config
+	httpClient *http.Client
+	auth       AuthStrategy
+	running    bool
+}
+
+// NewManifold creates a new Manifold Finance relay client
+func NewManifold(cfg Config) *Manifold {
+	return &Manifold{
+		config: cfg,
+		httpClient: &http.Client{
+			Timeout: cfg.SubmitTimeout,
+		},
+		auth: tokenAuth{token: cfg.ManifoldAuth},
+	}
+}
+
+// Name implements Relay.
+func (m *Manifold) Name() string { return "manifold" }
+
+// Start initializes the relay
+func (m *Manifold) Start(ctx context.Context) error {
+	log.Info().Msg("Starting Manifold relay")
+	m.running = true
+	return nil
+}
+
+// Stop shuts down the relay
+func (m *Manifold) Stop(ctx context.Context) {
+	log.Info().Msg("Stopping Manifold relay")
+	m.running = false
+}
+
+// SendBundle submits a bundle to Manifold
+func (m *Manifold) SendBundle(ctx context.Context, bundle *Bundle) (*BundleResponse, error) {
+	body, err := doJSONRPC(ctx, m.httpClient, m.auth, m.config.ManifoldURL, "eth_sendBundle", []interface{}{bundle}, m.config.MaxRetries)
+	if err != nil {
+		return nil, err
+	}
+
+	var result BundleResponse
+	if err := decodeJSONRPCResult("manifold error", body, &result); err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("bundleHash", result.BundleHash).
+		Int("txCount", len(bundle.Txs)).
+		Msg("Bundle submitted to Manifold")
+
+	return &result, nil
+}
+
+// SimulateBundle simulates a bundle via Manifold
+func (m *Manifold) SimulateBundle(ctx context.Context, bundle *Bundle) (*SimulationResult, error) {
+	body, err := doJSONRPC(ctx, m.httpClient, m.auth, m.config.ManifoldURL, "eth_callBundle", []interface{}{bundle}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SimulationResult
+	if err := decodeJSONRPCResult("simulation error", body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
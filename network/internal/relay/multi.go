@@ -0,0 +1,160 @@
+package relay
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// RelaySubmission is the per-relay result of a MultiRelay.SendBundle call.
+type RelaySubmission struct {
+	Relay    string
+	Accepted bool
+	Response *BundleResponse
+	Err      error
+	Latency  time.Duration
+	Included bool
+}
+
+// AggregateResult is the result of fanning a bundle out to every configured
+// relay.
+type AggregateResult struct {
+	Submissions []RelaySubmission
+}
+
+// Accepted returns the names of relays that accepted the bundle.
+func (a *AggregateResult) Accepted() []string {
+	var names []string
+	for _, s := range a.Submissions {
+		if s.Accepted {
+			names = append(names, s.Relay)
+		}
+	}
+	return names
+}
+
+// MultiRelay fans a bundle out to every registered Relay in parallel and
+// aggregates the per-relay results. Custom relays can be registered with
+// AddRelay without touching this package.
+type MultiRelay struct {
+	mu     sync.RWMutex
+	relays []Relay
+}
+
+// NewMultiRelay creates an aggregator seeded with an initial set of relays.
+func NewMultiRelay(relays ...Relay) *MultiRelay {
+	return &MultiRelay{relays: append([]Relay{}, relays...)}
+}
+
+// AddRelay registers another relay. Safe to call after Start.
+func (m *MultiRelay) AddRelay(r Relay) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.relays = append(m.relays, r)
+}
+
+// Start starts every registered relay.
+func (m *MultiRelay) Start(ctx context.Context) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, r := range m.relays {
+		if err := r.Start(ctx); err != nil {
+			return fmt.Errorf("starting relay %s: %w", r.Name(), err)
+		}
+	}
+	return nil
+}
+
+// Stop stops every registered relay.
+func (m *MultiRelay) Stop(ctx context.Context) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, r := range m.relays {
+		r.Stop(ctx)
+	}
+}
+
+// SendBundle submits bundle to every registered relay in parallel and
+// collects per-relay acceptance, latency, and error.
+func (m *MultiRelay) SendBundle(ctx context.Context, bundle *Bundle) *AggregateResult {
+	m.mu.RLock()
+	relays := append([]Relay{}, m.relays...)
+	m.mu.RUnlock()
+
+	results := make([]RelaySubmission, len(relays))
+	var wg sync.WaitGroup
+
+	for i, r := range relays {
+		wg.Add(1)
+		go func(i int, r Relay) {
+			defer wg.Done()
+
+			start := time.Now()
+			resp, err := r.SendBundle(ctx, bundle)
+			latency := time.Since(start)
+
+			results[i] = RelaySubmission{
+				Relay:    r.Name(),
+				Accepted: err == nil,
+				Response: resp,
+				Err:      err,
+				Latency:  latency,
+			}
+
+			if err != nil {
+				log.Warn().Err(err).Str("relay", r.Name()).Msg("Bundle rejected by relay")
+			} else {
+				log.Info().Str("relay", r.Name()).Dur("latency", latency).Msg("Bundle accepted by relay")
+			}
+		}(i, r)
+	}
+
+	wg.Wait()
+	return &AggregateResult{Submissions: results}
+}
+
+// CheckInclusion polls every relay that implements StatsProvider for whether
+// bundleHash was included at blockNumber, and annotates the matching entries
+// in result with the answer.
+func (m *MultiRelay) CheckInclusion(ctx context.Context, result *AggregateResult, bundleHash string, blockNumber uint64) {
+	m.mu.RLock()
+	relays := append([]Relay{}, m.relays...)
+	m.mu.RUnlock()
+
+	byName := make(map[string]Relay, len(relays))
+	for _, r := range relays {
+		byName[r.Name()] = r
+	}
+
+	for i := range result.Submissions {
+		sub := &result.Submissions[i]
+		if !sub.Accepted {
+			continue
+		}
+
+		provider, ok := byName[sub.Relay].(StatsProvider)
+		if !ok {
+			continue
+		}
+
+		stats, err := provider.GetBundleStats(ctx, bundleHash, blockNumber)
+		if err != nil {
+			log.Warn().Err(err).Str("relay", sub.Relay).Msg("Failed to fetch bundle stats")
+			continue
+		}
+
+		// isSimulated only reflects whether the relay ran its simulation
+		// step on submission, which is true for nearly every accepted
+		// bundle regardless of outcome. sealedByBuildersAt is set only
+		// once a builder actually seals the bundle into a block, so it's
+		// the signal that answers "did this land on-chain".
+		if sealedAt, ok := stats["sealedByBuildersAt"].(string); ok && sealedAt != "" {
+			sub.Included = true
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package relay
+
+import (
+	"context"
+	"net/http"
+)
+
+// Relay is implemented by every bundle-submission backend (Flashbots,
+// BloXroute, Eden, Manifold, or a custom one registered by a caller). It lets
+// MultiRelay fan a bundle out without knowing the wire format or auth scheme
+// of any particular relay.
+type Relay interface {
+	// Name identifies the relay in aggregate results and logs.
+	Name() string
+	Start(ctx context.Context) error
+	Stop(ctx context.Context)
+	SendBundle(ctx context.Context, bundle *Bundle) (*BundleResponse, error)
+	SimulateBundle(ctx context.Context, bundle *Bundle) (*SimulationResult, error)
+}
+
+// StatsProvider is implemented by relays that can report whether a
+// previously submitted bundle actually landed on-chain. Not every relay
+// exposes this (only Flashbots does today), so MultiRelay type-asserts for
+// it rather than requiring it on Relay.
+type StatsProvider interface {
+	GetBundleStats(ctx context.Context, bundleHash string, blockNumber uint64) (map[string]interface{}, error)
+}
+
+// AuthStrategy authenticates an outgoing relay request. Relays that use a
+// custom scheme (a signed-payload header, a bearer token)
+// implement this instead of hard-coding it into the client, so new relays
+// can be registered without patching existing ones.
+type AuthStrategy interface {
+	// Authenticate sets whatever headers the relay requires on req, given
+	// the raw (pre-send) JSON-RPC body.
+	Authenticate(req *http.Request, body []byte) error
+}
@@ -0,0 +1,48 @@
+package relay
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Bundle represents a Flashbots-style bundle. All relays in this package
+// accept the same shape, even though the JSON-RPC method name and auth
+// scheme used to submit it differ.
+type Bundle struct {
+	Txs               []string `json:"txs"`
+	BlockNumber       string   `json:"blockNumber"`
+	MinTimestamp      *uint64  `json:"minTimestamp,omitempty"`
+	MaxTimestamp      *uint64  `json:"maxTimestamp,omitempty"`
+	RevertingTxHashes []string `json:"revertingTxHashes,omitempty"`
+}
+
+// BundleResponse from a relay's bundle submission endpoint
+type BundleResponse struct {
+	BundleHash string `json:"bundleHash"`
+}
+
+// SimulationResult from eth_callBundle (or a relay-specific equivalent)
+type SimulationResult struct {
+	BundleGasPrice    string               `json:"bundleGasPrice"`
+	BundleHash        string               `json:"bundleHash"`
+	CoinbaseDiff      string               `json:"coinbaseDiff"`
+	EthSentToCoinbase string               `json:"ethSentToCoinbase"`
+	GasFees           string               `json:"gasFees"`
+	Results           []TxSimulationResult `json:"results"`
+	StateBlockNumber  uint64               `json:"stateBlockNumber"`
+	TotalGasUsed      uint64               `json:"totalGasUsed"`
+}
+
+// TxSimulationResult for individual tx
+type TxSimulationResult struct {
+	CoinbaseDiff      string         `json:"coinbaseDiff"`
+	EthSentToCoinbase string         `json:"ethSentToCoinbase"`
+	FromAddress       common.Address `json:"fromAddress"`
+	GasFees           string         `json:"gasFees"`
+	GasPrice          string         `json:"gasPrice"`
+	GasUsed           uint64         `json:"gasUsed"`
+	ToAddress         common.Address `json:"toAddress"`
+	TxHash            common.Hash    `json:"txHash"`
+	Value             string         `json:"value"`
+	Error             string         `json:"error,omitempty"`
+	Revert            string         `json:"revert,omitempty"`
+}
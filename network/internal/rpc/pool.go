@@ -2,9 +2,12 @@ package rpc
 
 import (
 	"context"
+	"math/big"
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/rs/zerolog/log"
 )
@@ -16,6 +19,26 @@ type Config struct {
 	RequestTimeout      time.Duration
 	ReconnectDelay      time.Duration
 	HealthCheckInterval time.Duration
+	// HealthCheckTimeout bounds each health check call. Defaults to 5s if unset.
+	HealthCheckTimeout time.Duration
+	// PerMethodTimeouts overrides RequestTimeout for specific JSON-RPC
+	// methods (e.g. "eth_getBlockByNumber", "eth_getBalance",
+	// "debug_traceCall") across all endpoints. Only methods actually
+	// issued through Client.CallContext or one of its typed wrappers
+	// (HeaderByNumber, BalanceAt) are governed by this; other promoted
+	// ethclient methods bypass it entirely.
+	PerMethodTimeouts map[string]time.Duration
+	// EndpointTimeouts further overrides RequestTimeout and
+	// PerMethodTimeouts for a single endpoint, keyed by the endpoint URL as
+	// it appears in Endpoints.
+	EndpointTimeouts map[string]Timeouts
+}
+
+// Timeouts overrides the pool-wide defaults for a single endpoint.
+type Timeouts struct {
+	RequestTimeout     time.Duration
+	HealthCheckTimeout time.Duration
+	PerMethodTimeouts  map[string]time.Duration
 }
 
 // Client wraps an eth client with metadata
@@ -24,6 +47,58 @@ type Client struct {
 	endpoint string
 	latency  time.Duration
 	healthy  bool
+	timeouts clientTimeouts
+}
+
+// clientTimeouts is the resolved (pool defaults + endpoint overrides)
+// timeout configuration for a single Client.
+type clientTimeouts struct {
+	request           time.Duration
+	healthCheck       time.Duration
+	perMethod         map[string]time.Duration
+	endpointPerMethod map[string]time.Duration
+}
+
+// timeoutFor returns the timeout to use for method, preferring an
+// endpoint-specific method override, then a pool-wide method override, then
+// the request timeout (the endpoint's RequestTimeout override if set,
+// otherwise the pool-wide RequestTimeout).
+func (c *Client) timeoutFor(method string) time.Duration {
+	if d, ok := c.timeouts.endpointPerMethod[method]; ok {
+		return d
+	}
+	if d, ok := c.timeouts.perMethod[method]; ok {
+		return d
+	}
+	return c.timeouts.request
+}
+
+// CallContext performs a generic JSON-RPC call through the underlying
+// ethclient, bounded by the timeout configured for (endpoint, method)
+// rather than a single pool-wide RequestTimeout.
+func (c *Client) CallContext(ctx context.Context, method string, result interface{}, args ...interface{}) error {
+	callCtx, cancel := context.WithTimeout(ctx, c.timeoutFor(method))
+	defer cancel()
+	return c.Client.Client().CallContext(callCtx, result, method, args...)
+}
+
+// HeaderByNumber fetches the header at number (nil for the latest),
+// shadowing the embedded ethclient method so the call is bounded by the
+// timeout configured for eth_getBlockByNumber rather than escaping
+// timeoutFor entirely.
+func (c *Client) HeaderByNumber(ctx context.Context, number *big.Int) (*gethtypes.Header, error) {
+	callCtx, cancel := context.WithTimeout(ctx, c.timeoutFor("eth_getBlockByNumber"))
+	defer cancel()
+	return c.Client.HeaderByNumber(callCtx, number)
+}
+
+// BalanceAt fetches account's balance at blockNumber, shadowing the
+// embedded ethclient method so the call is bounded by the timeout
+// configured for eth_getBalance rather than escaping timeoutFor entirely.
+func (c *Client) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	callCtx, cancel := context.WithTimeout(ctx, c.timeoutFor("eth_getBalance"))
+	defer cancel()
+	return c.Client.BalanceAt(callCtx, account, blockNumber)
 }
 
 // Pool manages multiple RPC connections
@@ -155,9 +230,40 @@ func (p *Pool) connect(ctx context.Context, endpoint string) (*Client, error) {
 		endpoint: endpoint,
 		latency:  latency,
 		healthy:  true,
+		timeouts: p.timeoutsFor(endpoint),
 	}, nil
 }
 
+// timeoutsFor resolves the effective clientTimeouts for endpoint, layering
+// its EndpointTimeouts override (if any) on top of the pool-wide defaults.
+func (p *Pool) timeoutsFor(endpoint string) clientTimeouts {
+	healthCheckTimeout := p.config.HealthCheckTimeout
+	if healthCheckTimeout == 0 {
+		healthCheckTimeout = 5 * time.Second
+	}
+
+	t := clientTimeouts{
+		request:     p.config.RequestTimeout,
+		healthCheck: healthCheckTimeout,
+		perMethod:   p.config.PerMethodTimeouts,
+	}
+
+	override, ok := p.config.EndpointTimeouts[endpoint]
+	if !ok {
+		return t
+	}
+
+	if override.RequestTimeout != 0 {
+		t.request = override.RequestTimeout
+	}
+	if override.HealthCheckTimeout != 0 {
+		t.healthCheck = override.HealthCheckTimeout
+	}
+	t.endpointPerMethod = override.PerMethodTimeouts
+
+	return t
+}
+
 func (p *Pool) healthCheckLoop(ctx context.Context) {
 	defer p.wg.Done()
 
@@ -183,7 +289,7 @@ func (p *Pool) checkHealth(ctx context.Context) {
 		start := time.Now()
 
 		// Simple health check: get block number
-		checkCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		checkCtx, cancel := context.WithTimeout(ctx, client.timeouts.healthCheck)
 		_, err := client.BlockNumber(checkCtx)
 		cancel()
 
@@ -0,0 +1,80 @@
+package rpc
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClientTimeoutFor(t *testing.T) {
+	c := &Client{
+		timeouts: clientTimeouts{
+			request:           5 * time.Second,
+			perMethod:         map[string]time.Duration{"eth_getBlockByNumber": 10 * time.Second},
+			endpointPerMethod: map[string]time.Duration{"eth_getBalance": 2 * time.Second},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		want   time.Duration
+	}{
+		{"endpoint-specific override wins", "eth_getBalance", 2 * time.Second},
+		{"pool-wide method override used when no endpoint override", "eth_getBlockByNumber", 10 * time.Second},
+		{"falls back to the pool-wide default", "debug_traceCall", 5 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.timeoutFor(tt.method); got != tt.want {
+				t.Errorf("timeoutFor(%q) = %v, want %v", tt.method, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPoolTimeoutsForLayersEndpointOverride(t *testing.T) {
+	p := &Pool{
+		config: Config{
+			RequestTimeout:     5 * time.Second,
+			HealthCheckTimeout: 3 * time.Second,
+			PerMethodTimeouts:  map[string]time.Duration{"eth_getBlockByNumber": 10 * time.Second},
+			EndpointTimeouts: map[string]Timeouts{
+				"https://node-a": {
+					RequestTimeout:    1 * time.Second,
+					PerMethodTimeouts: map[string]time.Duration{"eth_getBalance": 500 * time.Millisecond},
+				},
+			},
+		},
+	}
+
+	got := p.timeoutsFor("https://node-a")
+	if got.request != 1*time.Second {
+		t.Errorf("request = %v, want 1s", got.request)
+	}
+	if got.healthCheck != 3*time.Second {
+		t.Errorf("healthCheck = %v, want 3s (pool default, not overridden)", got.healthCheck)
+	}
+	if got.endpointPerMethod["eth_getBalance"] != 500*time.Millisecond {
+		t.Errorf("endpointPerMethod[eth_getBalance] = %v, want 500ms", got.endpointPerMethod["eth_getBalance"])
+	}
+	if got.perMethod["eth_getBlockByNumber"] != 10*time.Second {
+		t.Errorf("perMethod[eth_getBlockByNumber] = %v, want 10s", got.perMethod["eth_getBlockByNumber"])
+	}
+}
+
+func TestPoolTimeoutsForNoOverride(t *testing.T) {
+	p := &Pool{
+		config: Config{
+			RequestTimeout: 5 * time.Second,
+		},
+	}
+
+	got := p.timeoutsFor("https://node-b")
+	if got.request != 5*time.Second {
+		t.Errorf("request = %v, want 5s", got.request)
+	}
+	if got.healthCheck != 5*time.Second {
+		t.Errorf("healthCheck = %v, want 5s default", got.healthCheck)
+	}
+}
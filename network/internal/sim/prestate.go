@@ -0,0 +1,129 @@
+package sim
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+
+	"github.com/mev-protocol/network/pkg/types"
+)
+
+// prestateAccount mirrors a single account in debug_traceCall's
+// prestateTracer output: exactly the balance, nonce, code, and storage
+// slots the call touched.
+type prestateAccount struct {
+	Balance *hexutil.Big                `json:"balance"`
+	Nonce   uint64                      `json:"nonce"`
+	Code    hexutil.Bytes               `json:"code"`
+	Storage map[common.Hash]common.Hash `json:"storage"`
+}
+
+// seedCoinbase seeds fork with the coinbase's real on-chain balance as of
+// header, marking it seeded so prefetch won't overwrite it later. Called
+// once up front so a bundle tx that happens to touch the coinbase in its
+// own prestate doesn't reset it mid-simulation.
+func (s *Simulator) seedCoinbase(ctx context.Context, fork *forkedState, header *gethtypes.Header) error {
+	if fork.seeded[header.Coinbase] {
+		return nil
+	}
+
+	balance, err := fork.client.BalanceAt(ctx, header.Coinbase, header.Number)
+	if err != nil {
+		return fmt.Errorf("fetching coinbase balance: %w", err)
+	}
+
+	fork.SetBalance(header.Coinbase, uint256.MustFromBig(balance))
+	fork.seeded[header.Coinbase] = true
+	return nil
+}
+
+// prefetch fetches the prestate debug_traceCall would touch for btx against
+// header's block, seeds any not-yet-seen accounts into fork, and returns the
+// tx ready to execute.
+func (s *Simulator) prefetch(ctx context.Context, fork *forkedState, header *gethtypes.Header, btx types.BundleTx) (*core.Message, error) {
+	msg := toMessage(btx)
+
+	callArgs := map[string]interface{}{
+		"to":    msg.To,
+		"data":  hexutil.Bytes(msg.Data),
+		"value": (*hexutil.Big)(msg.Value),
+		"gas":   hexutil.Uint64(msg.GasLimit),
+	}
+
+	var prestate map[common.Address]prestateAccount
+	err := fork.client.CallContext(ctx, "debug_traceCall", &prestate, callArgs,
+		hexutil.EncodeUint64(header.Number.Uint64()),
+		map[string]interface{}{"tracer": "prestateTracer"},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("debug_traceCall prestate: %w", err)
+	}
+
+	for addr, acct := range prestate {
+		if fork.seeded[addr] {
+			continue
+		}
+		fork.seeded[addr] = true
+
+		if acct.Balance != nil {
+			fork.SetBalance(addr, uint256.MustFromBig((*big.Int)(acct.Balance)))
+		}
+		fork.SetNonce(addr, acct.Nonce)
+		if len(acct.Code) > 0 {
+			fork.SetCode(addr, acct.Code)
+		}
+		for slot, value := range acct.Storage {
+			fork.SetState(addr, slot, value)
+		}
+	}
+
+	return msg, nil
+}
+
+// toMessage converts a BundleTx into the core.Message ApplyMessage expects.
+// Legacy-priced txs (GasPrice set) use the same value for GasPrice,
+// GasFeeCap, and GasTipCap; EIP-1559 txs (GasPrice nil) carry their fee cap
+// and tip separately, so both must be read or the simulator prices them at
+// zero regardless of what the tx actually pays.
+func toMessage(tx types.BundleTx) *core.Message {
+	gasPrice := new(big.Int)
+	gasFeeCap := new(big.Int)
+	gasTipCap := new(big.Int)
+
+	if tx.GasPrice != nil {
+		gasPrice = new(big.Int).SetUint64(*tx.GasPrice)
+		gasFeeCap = gasPrice
+		gasTipCap = gasPrice
+	} else {
+		if tx.MaxFeePerGas != nil {
+			gasFeeCap = new(big.Int).SetUint64(*tx.MaxFeePerGas)
+		}
+		if tx.MaxPriorityFeePerGas != nil {
+			gasTipCap = new(big.Int).SetUint64(*tx.MaxPriorityFeePerGas)
+		}
+		gasPrice = gasFeeCap
+	}
+
+	var nonce uint64
+	if tx.Nonce != nil {
+		nonce = *tx.Nonce
+	}
+
+	to := tx.To
+	return &core.Message{
+		To:        &to,
+		Value:     new(big.Int).SetUint64(tx.Value),
+		GasLimit:  tx.GasLimit,
+		GasPrice:  gasPrice,
+		GasFeeCap: gasFeeCap,
+		GasTipCap: gasTipCap,
+		Nonce:     nonce,
+		Data:      tx.Data,
+	}
+}
@@ -0,0 +1,119 @@
+// Package sim provides an in-process eth_callBundle equivalent: it executes
+// a bundle against state forked from a live RPC endpoint using
+// go-ethereum's EVM directly, so simulation no longer has to round-trip to
+// a relay and can target arbitrary block heights or hypothetical mempool
+// orderings.
+package sim
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/mev-protocol/network/internal/rpc"
+	"github.com/mev-protocol/network/pkg/types"
+)
+
+// Config for the local simulator.
+type Config struct {
+	// ChainConfig describes the fork rules of the chain being simulated.
+	// Defaults to params.MainnetChainConfig if nil.
+	ChainConfig *params.ChainConfig
+}
+
+// Simulator executes bundles against state forked from an rpc.Pool client.
+// It implements the same Simulate(ctx, *types.Bundle) (*types.SimulationResult, error)
+// contract that internal/megabundle.Simulator expects, so the megabundle
+// coordinator can use it in place of (or alongside) relay-side simulation.
+type Simulator struct {
+	config  Config
+	rpcPool *rpc.Pool
+}
+
+// NewSimulator creates a local bundle simulator backed by pool.
+func NewSimulator(cfg Config, pool *rpc.Pool) *Simulator {
+	if cfg.ChainConfig == nil {
+		cfg.ChainConfig = params.MainnetChainConfig
+	}
+	return &Simulator{config: cfg, rpcPool: pool}
+}
+
+// Simulate executes bundle's transactions in order against state forked
+// from the parent of bundle.TargetBlock (or the current head, if unset),
+// returning the coinbase diff as Profit, the total gas used by the executed
+// transactions, the error (with the decoded revert reason, when there is one)
+// of the first failing transaction, and the storage slots written via SSTORE.
+// Gas used is not reported per transaction, and execution stops at the first
+// failing transaction.
+func (s *Simulator) Simulate(ctx context.Context, bundle *types.Bundle) (*types.SimulationResult, error) {
+	client, err := s.rpcPool.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("getting rpc client: %w", err)
+	}
+
+	var blockNumber *big.Int
+	if bundle.TargetBlock != 0 {
+		blockNumber = new(big.Int).SetUint64(bundle.TargetBlock - 1)
+	}
+
+	header, err := client.HeaderByNumber(ctx, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("fetching parent header: %w", err)
+	}
+
+	fork := newForkedState(client, header)
+
+	// Seed the coinbase before snapshotting its balance: debug_traceCall
+	// prestate for a later tx may touch it (e.g. a searcher contract that
+	// pays block.coinbase directly), and seeding it mid-loop would clobber
+	// coinbaseBefore with the account's real on-chain balance instead of
+	// this simulation's starting point.
+	if err := s.seedCoinbase(ctx, fork, header); err != nil {
+		return nil, fmt.Errorf("seeding coinbase: %w", err)
+	}
+	coinbaseBefore := fork.GetBalance(header.Coinbase)
+
+	blockCtx := core.NewEVMBlockContext(header, nil, &header.Coinbase)
+
+	result := &types.SimulationResult{Success: true}
+	gasPool := new(core.GasPool).AddGas(header.GasLimit)
+
+	for _, btx := range bundle.Transactions {
+		msg, err := s.prefetch(ctx, fork, header, btx)
+		if err != nil {
+			return nil, fmt.Errorf("prefetching state for tx: %w", err)
+		}
+
+		tracer := newSStoreTracer(fork)
+		evm := vm.NewEVM(blockCtx, core.NewEVMTxContext(msg), fork, s.config.ChainConfig, vm.Config{Tracer: tracer.logger()})
+
+		execResult, err := core.ApplyMessage(evm, msg, gasPool)
+		if err != nil {
+			result.Success = false
+			result.Error = err.Error()
+			break
+		}
+
+		result.GasUsed += execResult.UsedGas
+		result.StateChanges = append(result.StateChanges, tracer.changes...)
+
+		if execResult.Failed() {
+			result.Success = false
+			result.Error = execResult.Err.Error()
+			if reason, unpackErr := abi.UnpackRevert(execResult.Revert()); unpackErr == nil {
+				result.Error = fmt.Sprintf("%s: %s", result.Error, reason)
+			}
+			break
+		}
+	}
+
+	coinbaseAfter := fork.GetBalance(header.Coinbase)
+	result.Profit = new(big.Int).Sub(coinbaseAfter.ToBig(), coinbaseBefore.ToBig()).Int64()
+
+	return result, nil
+}
@@ -0,0 +1,31 @@
+package sim
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/mev-protocol/network/internal/rpc"
+)
+
+// forkedState is an in-memory state.StateDB seeded on demand from prestate
+// fetched over RPC (see prefetch in prestate.go), so the EVM can execute
+// against real chain state without replicating the whole trie locally.
+type forkedState struct {
+	*state.StateDB
+	client *rpc.Client
+	header *gethtypes.Header
+	seeded map[common.Address]bool
+}
+
+func newForkedState(client *rpc.Client, header *gethtypes.Header) *forkedState {
+	db, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+
+	return &forkedState{
+		StateDB: db,
+		client:  client,
+		header:  header,
+		seeded:  make(map[common.Address]bool),
+	}
+}
@@ -0,0 +1,69 @@
+package sim
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/mev-protocol/network/pkg/types"
+)
+
+// sstoreTracer watches EVM execution for SSTORE opcodes and records the
+// before/after value of each touched slot as a types.StateChange, so the
+// megabundle conflict detector can reason about what a simulated bundle
+// wrote without a separate state-diff pass.
+type sstoreTracer struct {
+	state   *forkedState
+	changes []types.StateChange
+}
+
+func newSStoreTracer(state *forkedState) *sstoreTracer {
+	return &sstoreTracer{state: state}
+}
+
+// logger adapts this tracer to go-ethereum's vm.EVMLogger interface.
+func (t *sstoreTracer) logger() vm.EVMLogger {
+	return &evmLoggerAdapter{tracer: t}
+}
+
+// evmLoggerAdapter implements vm.EVMLogger, forwarding only the SSTORE
+// opcode to sstoreTracer; every other hook is a no-op.
+type evmLoggerAdapter struct {
+	tracer *sstoreTracer
+}
+
+func (a *evmLoggerAdapter) CaptureTxStart(gasLimit uint64) {}
+
+func (a *evmLoggerAdapter) CaptureTxEnd(restGas uint64) {}
+
+func (a *evmLoggerAdapter) CaptureStart(env *vm.EVM, from, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+}
+
+func (a *evmLoggerAdapter) CaptureEnd(output []byte, gasUsed uint64, err error) {}
+
+func (a *evmLoggerAdapter) CaptureEnter(typ vm.OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int) {
+}
+
+func (a *evmLoggerAdapter) CaptureExit(output []byte, gasUsed uint64, err error) {}
+
+func (a *evmLoggerAdapter) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+}
+
+func (a *evmLoggerAdapter) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	if op != vm.SSTORE || len(scope.Stack.Data()) < 2 {
+		return
+	}
+
+	addr := scope.Contract.Address()
+	slot := common.Hash(scope.Stack.Back(0).Bytes32())
+	newValue := common.Hash(scope.Stack.Back(1).Bytes32())
+	oldValue := a.tracer.state.GetState(addr, slot)
+
+	a.tracer.changes = append(a.tracer.changes, types.StateChange{
+		Address:  addr,
+		Slot:     slot,
+		OldValue: oldValue,
+		NewValue: newValue,
+	})
+}
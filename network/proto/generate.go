@@ -0,0 +1,8 @@
+// Package proto holds the protobuf definitions shared between the Go node
+// and the Rust core.
+package proto
+
+//go:generate protoc --go_out=mevpb --go_opt=paths=source_relative --go-grpc_out=mevpb --go-grpc_opt=paths=source_relative mev.proto
+//
+// Rust stubs are generated separately by the core's tonic-build script from
+// this same mev.proto.